@@ -0,0 +1,94 @@
+package node
+
+import (
+	"context"
+
+	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/forbole/juno/v6/types"
+)
+
+// Node defines the full interface that must be implemented in order to connect to a chain
+// node and query it for the data needed to properly parse and store relevant data.
+//
+// It is composed of a ConsensusClient, which deals with the consensus-layer data (blocks,
+// validators, genesis, ...), and a TxService, which deals with submitting and querying
+// transactions. Keeping the two concerns separate allows a consensus engine implementation to
+// be swapped out without touching the transaction-handling code.
+type Node interface {
+	ConsensusClient
+	TxService
+
+	// Tx returns the transaction having the given hash
+	Tx(hash string) (*types.Transaction, error)
+
+	// Txs returns all the transactions contained inside the given block
+	Txs(block *Block) ([]*types.Transaction, error)
+
+	// TxSearch returns the list of transactions matching the given query
+	TxSearch(query string, page *int, perPage *int, orderBy string) (*TxSearchResult, error)
+
+	// Stop stops the connection to the node
+	Stop()
+}
+
+// TxService defines the set of queries and operations exposed by the Cosmos SDK
+// cosmos.tx.v1beta1.Service that Juno (and its downstream consumers) need in order to submit
+// transactions and page through tx history without maintaining their own gRPC client.
+type TxService interface {
+	// GetTx fetches a tx by hash
+	GetTx(ctx context.Context, hash string) (*sdktxtypes.GetTxResponse, error)
+
+	// GetTxsEvent fetches txs matching the given events, such as `tx.height=100`
+	GetTxsEvent(ctx context.Context, events []string, page, limit uint64) (*sdktxtypes.GetTxsEventResponse, error)
+
+	// Simulate simulates executing the given tx
+	Simulate(ctx context.Context, txBytes []byte) (*sdktxtypes.SimulateResponse, error)
+
+	// BroadcastTx broadcasts the given signed tx bytes
+	BroadcastTx(ctx context.Context, txBytes []byte, mode sdktxtypes.BroadcastMode) (*sdktxtypes.BroadcastTxResponse, error)
+}
+
+// ConsensusClient defines the set of consensus-layer queries that Juno needs in order to
+// index a chain: genesis, block headers, validator sets, block results and the live
+// consensus state.
+//
+// Implementations translate whatever wire format a given consensus engine exposes (CometBFT,
+// Rollkit, Penumbra ABCI++, ...) into the neutral types defined in this package, so the rest
+// of Juno never needs to depend on a specific consensus engine's types.
+type ConsensusClient interface {
+	// Genesis returns the genesis information of the chain
+	Genesis() (*Genesis, error)
+
+	// ConsensusState returns the current consensus state of the chain, as known by the node.
+	// Implementations that cannot expose this information should return ErrUnsupported; a
+	// light-client backed implementation returns ErrUnsupportedByLightNode instead.
+	ConsensusState() (*ConsensusState, error)
+
+	// LatestHeight returns the latest block height of the chain
+	LatestHeight() (int64, error)
+
+	// ChainID returns the chain id of the current chain
+	ChainID() (string, error)
+
+	// Validators returns the set of validators active at the given height
+	Validators(height int64) (*Validators, error)
+
+	// Block returns the block at the given height
+	Block(height int64) (*Block, error)
+
+	// BlockResults returns the results of the block at the given height
+	BlockResults(height int64) (*BlockResults, error)
+
+	// SubscribeEvents subscribes to the events matching the given query, returning a channel
+	// of raw events as well as a function that can be used to cancel the subscription.
+	//
+	// ctx only bounds the initial subscribe call: once it returns successfully, the resulting
+	// stream lives for as long as the node itself, until the returned CancelFunc is called, and
+	// is unaffected by ctx being cancelled or expiring afterwards.
+	//
+	// Subscribing to newly produced blocks specifically should go through a
+	// SubscriptionManager wrapping this Node, which fetches each block through Block on its own
+	// goroutine before handing it to its caller instead of leaving that to raw event parsing.
+	SubscribeEvents(ctx context.Context, subscriber, query string) (<-chan Event, context.CancelFunc, error)
+}
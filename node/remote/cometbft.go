@@ -0,0 +1,686 @@
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdkcodectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	constypes "github.com/cometbft/cometbft/consensus/types"
+	tmjson "github.com/cometbft/cometbft/libs/json"
+
+	"github.com/forbole/juno/v6/node"
+	"github.com/forbole/juno/v6/types"
+	vptypes "github.com/forbole/juno/v6/types/vp"
+
+	httpclient "github.com/cometbft/cometbft/rpc/client/http"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	_ node.Node = &CometBFTConsensus{}
+)
+
+// CometBFTConsensus implements node.Node by wrapping a CometBFT RPC client and a chain SDK
+// REST client, translating between CometBFT's own types and Juno's neutral node types so the
+// rest of Juno does not need to depend on CometBFT directly.
+type CometBFTConsensus struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	client *httpclient.HTTP
+
+	// rest fetches transactions through the chain SDK REST API, pooling connections, rate
+	// limiting and retrying transient failures on behalf of both Tx() and the Txs() worker
+	// pool.
+	rest *restClient
+
+	// txService backs the gRPC cosmos.tx.v1beta1.Service transport. It is unconfigured when the
+	// node was not given a GRPC address, in which case tx queries fall back to the REST API
+	// above.
+	txService *txServiceTransport
+
+	// vpVerifier verifies the SD-JWT verifiable presentation carried as every DChain block's
+	// first tx before its disclosed claims are indexed.
+	vpVerifier *vptypes.Verifier
+
+	// haltOnUnverifiableVP mirrors VPConfig.HaltOnUnverifiable: see that field's doc comment.
+	haltOnUnverifiableVP bool
+}
+
+// newRPCClient builds and starts a CometBFT RPC HTTP client pointed at address, tweaking its
+// transport to respect maxConnections. It is shared by every node.Node implementation in this
+// package that needs to talk to a CometBFT RPC endpoint (the full node, the light client's
+// primary and witnesses, ...).
+func newRPCClient(address string, maxConnections int) (*httpclient.HTTP, error) {
+	httpClient, err := jsonrpcclient.DefaultHTTPClient(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tweak the transport
+	httpTransport, ok := (httpClient.Transport).(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("invalid HTTP Transport: %T", httpTransport)
+	}
+	httpTransport.MaxConnsPerHost = maxConnections
+
+	rpcClient, err := httpclient.NewWithClient(address, "/websocket", httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rpcClient.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcClient, nil
+}
+
+// NewCometBFTConsensus allows to build a new CometBFTConsensus instance connected to the node
+// described by the given config
+func NewCometBFTConsensus(cfg *Details) (*CometBFTConsensus, error) {
+	rpcClient, err := newRPCClient(cfg.RPC.Address, cfg.RPC.MaxConnections)
+	if err != nil {
+		return nil, err
+	}
+
+	txService, err := newTxServiceTransport(cfg.GRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &CometBFTConsensus{
+		ctx:    ctx,
+		cancel: cancel,
+
+		client: rpcClient,
+		rest:   newRESTClient(cfg.API, cfg.RPC.MaxConnections),
+
+		txService: txService,
+
+		vpVerifier:           cfg.VP.verifier(),
+		haltOnUnverifiableVP: cfg.VP.HaltOnUnverifiable,
+	}, nil
+}
+
+// Genesis implements node.ConsensusClient
+func (cp *CometBFTConsensus) Genesis() (*node.Genesis, error) {
+	res, err := cp.client.Genesis(cp.ctx)
+	if err != nil && strings.Contains(err.Error(), "use the genesis_chunked API instead") {
+		res, err = cp.getGenesisChunked()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := tmjson.Marshal(res.Genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	return &node.Genesis{
+		ChainID:     res.Genesis.ChainID,
+		GenesisJSON: bz,
+	}, nil
+}
+
+// getGenesisChunked gets the genesis data using the chunked API instead
+func (cp *CometBFTConsensus) getGenesisChunked() (*tmctypes.ResultGenesis, error) {
+	bz, err := cp.getGenesisChunksStartingFrom(0)
+	if err != nil {
+		return nil, err
+	}
+
+	var genDoc *tmtypes.GenesisDoc
+	err = tmjson.Unmarshal(bz, &genDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tmctypes.ResultGenesis{Genesis: genDoc}, nil
+}
+
+// getGenesisChunksStartingFrom returns all the genesis chunks data starting from the chunk with the given id
+func (cp *CometBFTConsensus) getGenesisChunksStartingFrom(id uint) ([]byte, error) {
+	res, err := cp.client.GenesisChunked(cp.ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting genesis chunk %d out of %d", id, res.TotalChunks)
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(res.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error while decoding genesis chunk %d out of %d", id, res.TotalChunks)
+	}
+
+	if id == uint(res.TotalChunks-1) {
+		return bz, nil
+	}
+
+	nextChunk, err := cp.getGenesisChunksStartingFrom(id + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bz, nextChunk...), nil
+}
+
+// ConsensusState implements node.ConsensusClient
+func (cp *CometBFTConsensus) ConsensusState() (*node.ConsensusState, error) {
+	state, err := cp.client.ConsensusState(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	var data constypes.RoundStateSimple
+	err = tmjson.Unmarshal(state.RoundState, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &node.ConsensusState{
+		HeightRoundStep: data.HeightRoundStep,
+		Proposer:        data.Proposer.Address.String(),
+	}, nil
+}
+
+// LatestHeight implements node.ConsensusClient
+func (cp *CometBFTConsensus) LatestHeight() (int64, error) {
+	status, err := cp.client.Status(cp.ctx)
+	if err != nil {
+		return -1, err
+	}
+
+	height := status.SyncInfo.LatestBlockHeight
+	return height, nil
+}
+
+// ChainID implements node.ConsensusClient
+func (cp *CometBFTConsensus) ChainID() (string, error) {
+	status, err := cp.client.Status(cp.ctx)
+	if err != nil {
+		return "", err
+	}
+
+	chainID := status.NodeInfo.Network
+	return chainID, err
+}
+
+// Validators implements node.ConsensusClient
+func (cp *CometBFTConsensus) Validators(height int64) (*node.Validators, error) {
+	vals := &tmctypes.ResultValidators{
+		BlockHeight: height,
+	}
+
+	page := 1
+	perPage := 100 // maximum 100 entries per page
+	stop := false
+	for !stop {
+		result, err := cp.client.Validators(cp.ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		vals.Validators = append(vals.Validators, result.Validators...)
+		vals.Count += result.Count
+		vals.Total = result.Total
+		page++
+		stop = vals.Count == vals.Total
+	}
+
+	return toJunoValidators(vals), nil
+}
+
+// toJunoValidators converts a CometBFT ResultValidators into its Juno-native equivalent
+func toJunoValidators(vals *tmctypes.ResultValidators) *node.Validators {
+	converted := make([]node.Validator, len(vals.Validators))
+	for i, val := range vals.Validators {
+		converted[i] = node.Validator{
+			Address:          val.Address.Bytes(),
+			PubKey:           val.PubKey.Bytes(),
+			VotingPower:      val.VotingPower,
+			ProposerPriority: val.ProposerPriority,
+		}
+	}
+
+	return &node.Validators{
+		BlockHeight: vals.BlockHeight,
+		Validators:  converted,
+		Count:       vals.Count,
+		Total:       vals.Total,
+	}
+}
+
+// Block implements node.ConsensusClient
+func (cp *CometBFTConsensus) Block(height int64) (*node.Block, error) {
+	res, err := cp.client.Block(cp.ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	return toJunoBlock(res), nil
+}
+
+// toJunoBlock converts a CometBFT ResultBlock into its Juno-native equivalent
+func toJunoBlock(res *tmctypes.ResultBlock) *node.Block {
+	txs := make([][]byte, len(res.Block.Txs))
+	for i, tx := range res.Block.Txs {
+		txs[i] = tx
+	}
+
+	return &node.Block{
+		ID: node.BlockID{Hash: res.BlockID.Hash.Bytes()},
+		Header: node.Header{
+			ChainID:         res.Block.Header.ChainID,
+			Height:          res.Block.Header.Height,
+			Time:            res.Block.Header.Time,
+			ProposerAddress: res.Block.Header.ProposerAddress.Bytes(),
+		},
+		Txs: txs,
+	}
+}
+
+// BlockResults implements node.ConsensusClient
+func (cp *CometBFTConsensus) BlockResults(height int64) (*node.BlockResults, error) {
+	res, err := cp.client.BlockResults(cp.ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	return toJunoBlockResults(res), nil
+}
+
+// toJunoBlockResults converts a CometBFT ResultBlockResults into its Juno-native equivalent
+func toJunoBlockResults(res *tmctypes.ResultBlockResults) *node.BlockResults {
+	txsResults := make([]node.TxResult, len(res.TxsResults))
+	for i, txResult := range res.TxsResults {
+		txsResults[i] = node.TxResult{
+			Code:      txResult.Code,
+			Log:       txResult.Log,
+			GasWanted: txResult.GasWanted,
+			GasUsed:   txResult.GasUsed,
+			Events:    toJunoEvents(txResult.Events),
+		}
+	}
+
+	var consensusParams *node.ConsensusParams
+	if res.ConsensusParamUpdates != nil {
+		consensusParams = &node.ConsensusParams{
+			MaxBlockBytes: res.ConsensusParamUpdates.Block.MaxBytes,
+			MaxBlockGas:   res.ConsensusParamUpdates.Block.MaxGas,
+		}
+	}
+
+	validatorUpdates := make([]node.Validator, len(res.ValidatorUpdates))
+	for i, val := range res.ValidatorUpdates {
+		validatorUpdates[i] = node.Validator{
+			PubKey:      val.PubKey.GetEd25519(),
+			VotingPower: val.Power,
+		}
+	}
+
+	return &node.BlockResults{
+		Height:                res.Height,
+		TxsResults:            txsResults,
+		BeginBlockEvents:      toJunoEvents(res.BeginBlockEvents),
+		EndBlockEvents:        toJunoEvents(res.EndBlockEvents),
+		ValidatorUpdates:      validatorUpdates,
+		ConsensusParamUpdates: consensusParams,
+	}
+}
+
+// toJunoEvents converts a slice of ABCI events into their Juno-native equivalent
+func toJunoEvents(events []abcitypes.Event) []node.Event {
+	converted := make([]node.Event, len(events))
+	for i, event := range events {
+		attrs := make([]node.EventAttribute, len(event.Attributes))
+		for j, attr := range event.Attributes {
+			attrs[j] = node.EventAttribute{Key: attr.Key, Value: attr.Value}
+		}
+		converted[i] = node.Event{Type: event.Type, Attributes: attrs}
+	}
+	return converted
+}
+
+// Tx implements node.Node. It prefers the gRPC cosmos.tx.v1beta1.Service when the node was
+// configured with a GRPC address, falling back to the REST transport otherwise (or if the
+// gRPC call itself fails).
+func (cp *CometBFTConsensus) Tx(hash string) (*types.Transaction, error) {
+	if cp.txService.configured() {
+		tx, err := cp.txViaGRPC(hash)
+		if err == nil {
+			return tx, nil
+		}
+	}
+
+	return cp.rest.fetchTx(cp.ctx, hash)
+}
+
+// txViaGRPC fetches a single tx through the gRPC TxService
+func (cp *CometBFTConsensus) txViaGRPC(hash string) (*types.Transaction, error) {
+	res, err := cp.GetTx(cp.ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeGetTxResponse(res)
+}
+
+// decodeGetTxResponse converts a gRPC GetTxResponse into a types.Transaction, reusing the same
+// JSON shape the REST API produces so that both transports feed the exact same decoding logic.
+func decodeGetTxResponse(res *sdktxtypes.GetTxResponse) (*types.Transaction, error) {
+	bz, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling tx response: %w", err)
+	}
+
+	var convTx *types.Transaction
+	err = json.Unmarshal(bz, &convTx)
+	if err != nil {
+		return nil, fmt.Errorf("error converting transaction: %s", err.Error())
+	}
+
+	return convTx, nil
+}
+
+// Txs implements node.Node
+// NOTE: DChain first tx is always the verifiable presentation so we do not parse it for now
+// TODO display this
+func (cp *CometBFTConsensus) Txs(block *node.Block) ([]*types.Transaction, error) {
+	txResponses := make([]*types.Transaction, len(block.Txs))
+	if len(block.Txs) == 0 {
+		return txResponses, nil
+	}
+
+	vp, err := cp.HandleVPTxs(block.Txs[0], block)
+	if err != nil {
+		if cp.haltOnUnverifiableVP {
+			return nil, err
+		}
+	} else {
+		txResponses[0] = vp
+	}
+
+	if len(block.Txs) == 1 {
+		return txResponses, nil
+	}
+
+	if cp.txService.configured() {
+		grpcTxs, err := cp.txsViaGRPC(block)
+		if err == nil {
+			copy(txResponses[1:], grpcTxs)
+			return txResponses, nil
+		}
+	}
+
+	hashes := make([]string, len(block.Txs)-1)
+	for i := 1; i < len(block.Txs); i++ {
+		hashes[i-1] = fmt.Sprintf("%X", tmtypes.Tx(block.Txs[i]).Hash())
+	}
+
+	restTxs, err := cp.rest.fetchTxsBatch(cp.ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	copy(txResponses[1:], restTxs)
+
+	return txResponses, nil
+}
+
+// txsViaGRPC fetches every tx but the first (the verifiable presentation) contained in block
+// using a single GetTxsEvent call instead of one REST roundtrip per tx
+func (cp *CometBFTConsensus) txsViaGRPC(block *node.Block) ([]*types.Transaction, error) {
+	res, err := cp.GetTxsEvent(cp.ctx, []string{fmt.Sprintf("tx.height=%d", block.Header.Height)}, 1, uint64(len(block.Txs)))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Txs) != len(block.Txs)-1 {
+		return nil, fmt.Errorf("expected %d txs at height %d, got %d", len(block.Txs)-1, block.Header.Height, len(res.Txs))
+	}
+
+	txs := make([]*types.Transaction, len(res.Txs))
+	for i := range res.Txs {
+		tx, err := decodeGetTxResponse(&sdktxtypes.GetTxResponse{Tx: res.Txs[i], TxResponse: res.TxResponses[i]})
+		if err != nil {
+			return nil, err
+		}
+		txs[i] = tx
+	}
+
+	return txs, nil
+}
+
+func (cp *CometBFTConsensus) HandleVPTxs(txn []byte, block *node.Block) (*types.Transaction, error) {
+	return handleVPTx(txn, block, cp.vpVerifier)
+}
+
+// handleVPTx builds the synthetic types.Transaction representing a block's Verifiable
+// Presentation tx (always the first tx of a DChain block). It is shared by every node.Node
+// implementation in this package, since VP parsing does not depend on the consensus transport
+// used to reach the node.
+//
+// verifier checks the issuer signature, the SD hash-to-disclosure binding and the optional
+// holder key-binding JWT before any disclosed value is trusted; a nil verifier is rejected so
+// a misconfigured node fails closed instead of silently indexing unverified presentations.
+func handleVPTx(txn []byte, block *node.Block, verifier *vptypes.Verifier) (*types.Transaction, error) {
+	if verifier == nil {
+		return nil, fmt.Errorf("%w: no verifiable presentation verifier configured", vptypes.ErrUnresolvableIssuer)
+	}
+
+	disclosedJson, err := verifier.Verify(strings.TrimSpace(string(txn)))
+	if err != nil {
+		return nil, fmt.Errorf("error verifying verifiable presentation: %w", err)
+	}
+
+	// Add Type into message
+	disclosedJson["@type"] = types.VP_TYPE
+
+	jsonBytes, err := json.Marshal(disclosedJson)
+	if err != nil {
+		return nil, err
+	}
+
+	txAny := &sdkcodectypes.Any{
+		TypeUrl: types.VP_TYPE,
+		Value:   jsonBytes,
+	}
+
+	// Compile fake txBody
+	sdkTxBody := sdktxtypes.TxBody{
+		Memo: "Verifiable Presentation",
+		Messages: []*sdkcodectypes.Any{
+			txAny,
+		},
+	}
+	txBody := types.TxBody{
+		TxBody:        &sdkTxBody,
+		TimeoutHeight: uint64(block.Header.Height),
+		Messages: []types.Message{
+			types.NewVPStandardMessage(jsonBytes),
+		},
+	}
+
+	// Compile fake tx
+	sdkTx := sdktxtypes.Tx{
+		Body:       &sdkTxBody,
+		Signatures: [][]byte{},
+	}
+	tx := &types.Tx{
+		Tx:   &sdkTx,
+		Body: &txBody,
+		AuthInfo: &types.AuthInfo{
+			SignerInfos: []*types.SignerInfo{},
+			Fee:         &types.Fee{},
+		},
+	}
+
+	// Make salted hash, cause VP is not changing so fast
+	salt := make([]byte, 8)
+	binary.LittleEndian.PutUint64(salt, uint64(block.Header.Height))
+	finalBytes := append(jsonBytes, salt...)
+
+	hash := sha256.Sum256(finalBytes)
+
+	// Compile fake txResponse
+	valAddr, err := sdktypes.ValAddressFromHex(fmt.Sprintf("%X", block.Header.ProposerAddress))
+	if err != nil {
+		return nil, err
+	}
+	sdkTxResponse := &sdktypes.TxResponse{
+		Tx: txAny,
+		Events: []abcitypes.Event{
+			{
+				Type: "message",
+				Attributes: []abcitypes.EventAttribute{
+					{
+						Key:   "proposer",
+						Value: valAddr.String(),
+					},
+				},
+			},
+		},
+		Height: block.Header.Height,
+		TxHash: fmt.Sprintf("%X", hash[:]),
+	}
+
+	txResponse := &types.TxResponse{
+		TxResponse: sdkTxResponse,
+		Height:     uint64(block.Header.Height),
+		GasWanted:  uint64(0),
+		GasUsed:    uint64(0),
+		Tx:         tx,
+	}
+
+	return &types.Transaction{
+		TxResponse: txResponse,
+		Tx:         tx,
+	}, nil
+}
+
+// TxSearch implements node.Node
+func (cp *CometBFTConsensus) TxSearch(query string, page *int, perPage *int, orderBy string) (*node.TxSearchResult, error) {
+	res, err := cp.client.TxSearch(cp.ctx, query, false, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([][]byte, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = tx.Tx
+	}
+
+	return &node.TxSearchResult{Txs: txs, TotalCount: res.TotalCount}, nil
+}
+
+// SubscribeEvents implements node.ConsensusClient
+func (cp *CometBFTConsensus) SubscribeEvents(ctx context.Context, subscriber, query string) (<-chan node.Event, context.CancelFunc, error) {
+	return subscribeEvents(ctx, cp.ctx, cp.client, subscriber, query)
+}
+
+// subscribeEvents subscribes to query on client, honoring dialCtx to bound the initial
+// subscribe call only: the returned stream and cancel func are tied to lifetimeCtx instead (the
+// owning node's own context), so the subscription survives dialCtx being cancelled or expiring
+// and is only torn down when the returned CancelFunc is called, or lifetimeCtx ends (e.g. via
+// Stop()). It is shared by every node.Node implementation in this package, since subscribing
+// does not depend on the consensus transport used to reach the node.
+func subscribeEvents(dialCtx, lifetimeCtx context.Context, client *httpclient.HTTP, subscriber, query string) (<-chan node.Event, context.CancelFunc, error) {
+	subCtx, cancel := context.WithCancel(lifetimeCtx)
+
+	type dialResult struct {
+		ch  <-chan tmctypes.ResultEvent
+		err error
+	}
+	dialed := make(chan dialResult, 1)
+	go func() {
+		ch, err := client.Subscribe(subCtx, subscriber, query)
+		dialed <- dialResult{ch, err}
+	}()
+
+	select {
+	case <-dialCtx.Done():
+		cancel()
+		return nil, nil, dialCtx.Err()
+
+	case res := <-dialed:
+		if res.err != nil {
+			cancel()
+			return nil, nil, res.err
+		}
+
+		out := make(chan node.Event)
+		go func() {
+			defer close(out)
+			for event := range res.ch {
+				select {
+				case out <- toJunoEvent(event):
+				case <-subCtx.Done():
+					return
+				}
+			}
+		}()
+
+		return out, func() {
+			cancel()
+			_ = client.Unsubscribe(context.Background(), subscriber, query)
+		}, nil
+	}
+}
+
+// toJunoEvent converts a CometBFT ResultEvent into its Juno-native equivalent
+func toJunoEvent(re tmctypes.ResultEvent) node.Event {
+	attrs := make([]node.EventAttribute, 0, len(re.Events))
+	for key, values := range re.Events {
+		for _, value := range values {
+			attrs = append(attrs, node.EventAttribute{Key: key, Value: value})
+		}
+	}
+	return node.Event{Type: re.Query, Attributes: attrs}
+}
+
+// Stop implements node.Node
+func (cp *CometBFTConsensus) Stop() {
+	cp.cancel()
+
+	err := cp.client.Stop()
+	if err != nil {
+		panic(fmt.Errorf("error while stopping proxy: %s", err))
+	}
+
+	if err := cp.txService.Close(); err != nil {
+		panic(fmt.Errorf("error while closing tx service gRPC connection: %s", err))
+	}
+}
+
+// GetTx implements node.TxService
+func (cp *CometBFTConsensus) GetTx(ctx context.Context, hash string) (*sdktxtypes.GetTxResponse, error) {
+	return cp.txService.GetTx(ctx, hash)
+}
+
+// GetTxsEvent implements node.TxService
+func (cp *CometBFTConsensus) GetTxsEvent(ctx context.Context, events []string, page, limit uint64) (*sdktxtypes.GetTxsEventResponse, error) {
+	return cp.txService.GetTxsEvent(ctx, events, page, limit)
+}
+
+// Simulate implements node.TxService
+func (cp *CometBFTConsensus) Simulate(ctx context.Context, txBytes []byte) (*sdktxtypes.SimulateResponse, error) {
+	return cp.txService.Simulate(ctx, txBytes)
+}
+
+// BroadcastTx implements node.TxService
+func (cp *CometBFTConsensus) BroadcastTx(ctx context.Context, txBytes []byte, mode sdktxtypes.BroadcastMode) (*sdktxtypes.BroadcastTxResponse, error) {
+	return cp.txService.BroadcastTx(ctx, txBytes, mode)
+}
@@ -0,0 +1,155 @@
+package remote
+
+import (
+	"time"
+
+	"github.com/forbole/juno/v6/types/vp"
+)
+
+const (
+	// EngineCometBFT identifies the full-node CometBFT consensus engine implementation
+	EngineCometBFT = "cometbft"
+
+	// EngineLightClient identifies the CometBFT light-client consensus engine implementation
+	EngineLightClient = "light"
+)
+
+// RPCConfig contains the configuration of the CometBFT RPC endpoint used to query consensus
+// data (blocks, validators, genesis, ...)
+type RPCConfig struct {
+	Address        string
+	MaxConnections int
+}
+
+// APIConfig contains the configuration of the chain SDK REST endpoint used to query
+// transactions
+type APIConfig struct {
+	Address string
+
+	// Concurrency bounds how many tx fetches Txs() issues to Address at once. Defaults to
+	// RPC.MaxConnections when zero, and to defaultAPIConcurrency if that is also zero.
+	Concurrency int
+
+	// RPS rate-limits the requests issued to Address to at most RPS per second, shared across
+	// every concurrent fetch. Zero disables rate limiting.
+	RPS float64
+
+	// MaxRetries bounds how many times a single tx fetch is retried (with exponential
+	// backoff and jitter) after a 429, a 5xx or a context deadline exceeded error. Defaults to
+	// defaultAPIMaxRetries when zero.
+	MaxRetries int
+}
+
+// GRPCConfig contains the configuration of the Cosmos SDK gRPC endpoint used to submit and
+// query transactions through the cosmos.tx.v1beta1.Service. When Address is left empty, the
+// node falls back to querying transactions through APIConfig's REST endpoint instead.
+type GRPCConfig struct {
+	Address string
+
+	// Insecure disables TLS when dialing Address. Defaults to false (TLS enabled).
+	Insecure bool
+
+	// KeepAliveTime is the interval between gRPC keepalive pings
+	KeepAliveTime time.Duration
+
+	// CallTimeout bounds the duration of a single gRPC call made through the TxService.
+	// Defaults to 10 seconds when zero.
+	CallTimeout time.Duration
+}
+
+// LightClientConfig contains the configuration needed to verify a chain through a CometBFT
+// light client instead of trusting a single full node outright.
+type LightClientConfig struct {
+	// ChainID is the id of the chain being verified
+	ChainID string
+
+	// Primary is the RPC address of the full node whose responses are verified
+	Primary string
+
+	// Witnesses are the RPC addresses of the full nodes used to cross-check the primary and
+	// detect fork attempts. At least one witness is required.
+	Witnesses []string
+
+	// TrustPeriod is the period during which headers can still be verified
+	TrustPeriod time.Duration
+
+	// TrustHeight and TrustHash bootstrap the trust chain: the header at TrustHeight must
+	// hash to TrustHash and is trusted outright, with every other header verified against it
+	TrustHeight int64
+	TrustHash   string
+
+	// TrustLevelNumerator/Denominator express the fraction of the validator set that must
+	// sign for a header to be trusted (e.g. 1/3, the CometBFT default)
+	TrustLevelNumerator   int64
+	TrustLevelDenominator int64
+}
+
+// VPConfig controls how the verifiable presentation carried as a DChain block's first tx is
+// verified before its disclosed claims are indexed. It is a thin, config-file-friendly mirror
+// of vp.Config.
+type VPConfig struct {
+	// TrustedIssuers is the allowlist of issuer DIDs a presentation may come from.
+	TrustedIssuers []string
+
+	// SupportedAlgorithms restricts the `alg` the issuer and key-binding JWTs may use.
+	// Defaults to {"ES256", "EdDSA"} when left empty.
+	SupportedAlgorithms []string
+
+	// Strict additionally rejects a presentation whose issuer payload has an `_sd` digest
+	// with no matching disclosure.
+	Strict bool
+
+	// RequireHolderBinding rejects a presentation that does not carry a key-binding JWT.
+	RequireHolderBinding bool
+
+	// ExpectedAudience and ExpectedNonce are the aud/nonce the key-binding JWT must carry.
+	ExpectedAudience string
+	ExpectedNonce    string
+
+	// ClockSkew bounds how far the key-binding JWT's iat may drift from now. Defaults to 5
+	// minutes when zero.
+	ClockSkew time.Duration
+
+	// HaltOnUnverifiable, when true, makes Txs() abort the whole block fetch with an error if
+	// the block's verifiable presentation tx fails verification. When false (the default),
+	// that tx is simply omitted from the result (left nil) and the rest of the block's txs are
+	// still returned, so a node brought up without TrustedIssuers configured (e.g. an existing
+	// config predating this field) keeps indexing instead of getting stuck on block 1.
+	HaltOnUnverifiable bool
+}
+
+// verifier builds the vp.Verifier described by cfg, using vp.NewMultiResolver's default
+// did:web/did:key resolution.
+func (cfg VPConfig) verifier() *vp.Verifier {
+	return vp.NewVerifier(vp.Config{
+		TrustedIssuers:       cfg.TrustedIssuers,
+		SupportedAlgorithms:  cfg.SupportedAlgorithms,
+		Strict:               cfg.Strict,
+		RequireHolderBinding: cfg.RequireHolderBinding,
+		ExpectedAudience:     cfg.ExpectedAudience,
+		ExpectedNonce:        cfg.ExpectedNonce,
+		ClockSkew:            cfg.ClockSkew,
+	}, vp.NewMultiResolver())
+}
+
+// Details contains the configuration needed to connect to a remote chain node
+type Details struct {
+	// Engine selects which node.Node implementation should be built by NewNode. Defaults to
+	// EngineCometBFT when left empty, so existing configurations keep working unchanged.
+	Engine string
+
+	RPC         RPCConfig
+	API         APIConfig
+	GRPC        GRPCConfig
+	LightClient LightClientConfig
+	VP          VPConfig
+}
+
+// NewDetails returns a new Details instance
+func NewDetails(rpc RPCConfig, api APIConfig) *Details {
+	return &Details{
+		Engine: EngineCometBFT,
+		RPC:    rpc,
+		API:    api,
+	}
+}
@@ -0,0 +1,29 @@
+package remote
+
+import (
+	"fmt"
+
+	"github.com/forbole/juno/v6/node"
+)
+
+// NewNode allows to build a new node.Node instance connected to the remote chain described by
+// the given config, selecting the underlying consensus engine implementation based on
+// cfg.Engine. An empty Engine defaults to CometBFT so that existing configurations keep
+// working unchanged.
+func NewNode(cfg *Details) (node.Node, error) {
+	engine := cfg.Engine
+	if engine == "" {
+		engine = EngineCometBFT
+	}
+
+	switch engine {
+	case EngineCometBFT:
+		return NewCometBFTConsensus(cfg)
+
+	case EngineLightClient:
+		return NewLightNode(cfg)
+
+	default:
+		return nil, fmt.Errorf("unknown consensus engine: %s", engine)
+	}
+}
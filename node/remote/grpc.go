@@ -0,0 +1,155 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+const defaultGRPCCallTimeout = 10 * time.Second
+
+// dialTxServiceGRPC dials the Cosmos SDK cosmos.tx.v1beta1.Service gRPC endpoint described by
+// cfg, returning nil (and no error) when no address has been configured so that callers can
+// fall back to the REST transport instead.
+func dialTxServiceGRPC(cfg GRPCConfig) (*grpc.ClientConn, error) {
+	if cfg.Address == "" {
+		return nil, nil
+	}
+
+	transportCreds := credentials.NewTLS(&tls.Config{})
+	if cfg.Insecure {
+		transportCreds = insecure.NewCredentials()
+	}
+
+	keepAliveTime := cfg.KeepAliveTime
+	if keepAliveTime == 0 {
+		keepAliveTime = 30 * time.Second
+	}
+
+	conn, err := grpc.NewClient(
+		cfg.Address,
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    keepAliveTime,
+			Timeout: keepAliveTime,
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while dialing tx service gRPC endpoint: %w", err)
+	}
+
+	return conn, nil
+}
+
+// txServiceTransport implements node.TxService over the Cosmos SDK cosmos.tx.v1beta1.Service
+// gRPC endpoint, shared by every node.Node implementation in this package so a fix to the tx
+// service transport (timeout handling, the "not configured" guard, ...) is never missed on one
+// of them.
+type txServiceTransport struct {
+	conn        *grpc.ClientConn
+	client      sdktxtypes.ServiceClient
+	callTimeout time.Duration
+}
+
+// newTxServiceTransport dials cfg's gRPC endpoint and builds the txServiceTransport calling it.
+// configured() reports false (and every call fails) when cfg.Address is left empty, in which
+// case callers are expected to fall back to the REST transport instead.
+func newTxServiceTransport(cfg GRPCConfig) (*txServiceTransport, error) {
+	conn, err := dialTxServiceGRPC(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var client sdktxtypes.ServiceClient
+	if conn != nil {
+		client = sdktxtypes.NewServiceClient(conn)
+	}
+
+	return &txServiceTransport{conn: conn, client: client, callTimeout: cfg.CallTimeout}, nil
+}
+
+// configured reports whether t was built with a gRPC address, i.e. whether its calls can
+// actually be served instead of immediately failing.
+func (t *txServiceTransport) configured() bool {
+	return t.client != nil
+}
+
+// timeout returns the configured per-call gRPC deadline, falling back to
+// defaultGRPCCallTimeout when unset
+func (t *txServiceTransport) timeout() time.Duration {
+	if t.callTimeout == 0 {
+		return defaultGRPCCallTimeout
+	}
+	return t.callTimeout
+}
+
+// Close closes the underlying gRPC connection, if any was dialed.
+func (t *txServiceTransport) Close() error {
+	if t.conn == nil {
+		return nil
+	}
+	return t.conn.Close()
+}
+
+// GetTx calls cosmos.tx.v1beta1.Service/GetTx
+func (t *txServiceTransport) GetTx(ctx context.Context, hash string) (*sdktxtypes.GetTxResponse, error) {
+	if !t.configured() {
+		return nil, fmt.Errorf("tx service gRPC endpoint not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout())
+	defer cancel()
+
+	return t.client.GetTx(ctx, &sdktxtypes.GetTxRequest{Hash: hash})
+}
+
+// GetTxsEvent calls cosmos.tx.v1beta1.Service/GetTxsEvent
+func (t *txServiceTransport) GetTxsEvent(ctx context.Context, events []string, page, limit uint64) (*sdktxtypes.GetTxsEventResponse, error) {
+	if !t.configured() {
+		return nil, fmt.Errorf("tx service gRPC endpoint not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout())
+	defer cancel()
+
+	return t.client.GetTxsEvent(ctx, &sdktxtypes.GetTxsEventRequest{
+		Events: events,
+		Page:   page,
+		Limit:  limit,
+	})
+}
+
+// Simulate calls cosmos.tx.v1beta1.Service/Simulate
+func (t *txServiceTransport) Simulate(ctx context.Context, txBytes []byte) (*sdktxtypes.SimulateResponse, error) {
+	if !t.configured() {
+		return nil, fmt.Errorf("tx service gRPC endpoint not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout())
+	defer cancel()
+
+	return t.client.Simulate(ctx, &sdktxtypes.SimulateRequest{TxBytes: txBytes})
+}
+
+// BroadcastTx calls cosmos.tx.v1beta1.Service/BroadcastTx
+func (t *txServiceTransport) BroadcastTx(ctx context.Context, txBytes []byte, mode sdktxtypes.BroadcastMode) (*sdktxtypes.BroadcastTxResponse, error) {
+	if !t.configured() {
+		return nil, fmt.Errorf("tx service gRPC endpoint not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout())
+	defer cancel()
+
+	return t.client.BroadcastTx(ctx, &sdktxtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    mode,
+	})
+}
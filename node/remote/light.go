@@ -0,0 +1,358 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmmath "github.com/cometbft/cometbft/libs/math"
+	"github.com/cometbft/cometbft/light"
+	lightprovider "github.com/cometbft/cometbft/light/provider/http"
+	lightstore "github.com/cometbft/cometbft/light/store/db"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	httpclient "github.com/cometbft/cometbft/rpc/client/http"
+
+	"github.com/forbole/juno/v6/node"
+	"github.com/forbole/juno/v6/types"
+	vptypes "github.com/forbole/juno/v6/types/vp"
+
+	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	_ node.Node = &LightNode{}
+)
+
+// LightNode implements node.Node on top of a CometBFT light client instead of trusting a
+// single full node outright: every block header and validator set it returns, and the
+// per-tx part of every set of block results (see BlockResults), has been verified against a
+// chain of trusted headers rooted at a bootstrap trust height/hash, cross-checked with a set
+// of witnesses to detect forks.
+//
+// Data that cannot be tied back to a header's hash (such as the live consensus round state)
+// is simply not verifiable by a light client and causes these calls to return
+// node.ErrUnsupportedByLightNode.
+type LightNode struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lc      *light.Client
+	primary *httpclient.HTTP
+
+	// rest fetches transactions through the chain SDK REST API, pooling connections, rate
+	// limiting and retrying transient failures.
+	rest *restClient
+
+	// txService backs the gRPC cosmos.tx.v1beta1.Service transport. It is unconfigured when the
+	// node was not given a GRPC address, in which case tx queries fall back to the REST API
+	// above.
+	txService *txServiceTransport
+
+	// vpVerifier verifies the SD-JWT verifiable presentation carried as every DChain block's
+	// first tx before its disclosed claims are indexed.
+	vpVerifier *vptypes.Verifier
+
+	// haltOnUnverifiableVP mirrors VPConfig.HaltOnUnverifiable: see that field's doc comment.
+	haltOnUnverifiableVP bool
+}
+
+// NewLightNode allows to build a new LightNode instance verifying the chain described by the
+// given config's LightClient settings
+func NewLightNode(cfg *Details) (*LightNode, error) {
+	lcCfg := cfg.LightClient
+
+	if lcCfg.Primary == "" {
+		return nil, fmt.Errorf("light client requires a primary RPC address")
+	}
+	if len(lcCfg.Witnesses) == 0 {
+		return nil, fmt.Errorf("light client requires at least one witness RPC address")
+	}
+
+	primaryProvider, err := lightprovider.New(lcCfg.ChainID, lcCfg.Primary)
+	if err != nil {
+		return nil, fmt.Errorf("error building light client primary provider: %w", err)
+	}
+
+	witnessProviders := make([]lightprovider.Provider, len(lcCfg.Witnesses))
+	for i, witness := range lcCfg.Witnesses {
+		wp, err := lightprovider.New(lcCfg.ChainID, witness)
+		if err != nil {
+			return nil, fmt.Errorf("error building light client witness provider %d: %w", i, err)
+		}
+		witnessProviders[i] = wp
+	}
+
+	witnesses := make([]light.Provider, len(witnessProviders))
+	for i, wp := range witnessProviders {
+		witnesses[i] = wp
+	}
+
+	trustHash, err := hex.DecodeString(lcCfg.TrustHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid light client trust hash: %w", err)
+	}
+
+	trustLevel, err := tmmath.NewFraction(lcCfg.TrustLevelNumerator, lcCfg.TrustLevelDenominator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid light client trust level: %w", err)
+	}
+
+	lc, err := light.NewClient(
+		context.Background(),
+		lcCfg.ChainID,
+		light.TrustOptions{
+			Period: lcCfg.TrustPeriod,
+			Height: lcCfg.TrustHeight,
+			Hash:   trustHash,
+		},
+		primaryProvider,
+		witnesses,
+		lightstore.New(dbm.NewMemDB(), ""),
+		light.TrustLevel(trustLevel),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error building light client: %w", err)
+	}
+
+	primary, err := newRPCClient(lcCfg.Primary, cfg.RPC.MaxConnections)
+	if err != nil {
+		return nil, err
+	}
+
+	txService, err := newTxServiceTransport(cfg.GRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &LightNode{
+		ctx:    ctx,
+		cancel: cancel,
+
+		lc:      lc,
+		primary: primary,
+
+		rest: newRESTClient(cfg.API, cfg.RPC.MaxConnections),
+
+		txService: txService,
+
+		vpVerifier:           cfg.VP.verifier(),
+		haltOnUnverifiableVP: cfg.VP.HaltOnUnverifiable,
+	}, nil
+}
+
+// Genesis implements node.ConsensusClient. The genesis document predates the light client's
+// trust chain and so cannot be verified against it.
+func (ln *LightNode) Genesis() (*node.Genesis, error) {
+	return nil, node.ErrUnsupportedByLightNode
+}
+
+// ConsensusState implements node.ConsensusClient. The live round state has no commit to verify
+// it against, so it is never exposed by a light client.
+func (ln *LightNode) ConsensusState() (*node.ConsensusState, error) {
+	return nil, node.ErrUnsupportedByLightNode
+}
+
+// LatestHeight implements node.ConsensusClient
+func (ln *LightNode) LatestHeight() (int64, error) {
+	header, err := ln.lc.Update(time.Now())
+	if err != nil {
+		return -1, err
+	}
+	if header == nil {
+		// Update returns no header when the trusted state is already at the latest height
+		return ln.lc.LastTrustedHeight()
+	}
+	return header.Height, nil
+}
+
+// ChainID implements node.ConsensusClient
+func (ln *LightNode) ChainID() (string, error) {
+	return ln.lc.ChainID(), nil
+}
+
+// Validators implements node.ConsensusClient. The returned validator set comes straight from
+// the light client's verified light block, so it never needs an extra round-trip to a node
+// that might be lying about it.
+func (ln *LightNode) Validators(height int64) (*node.Validators, error) {
+	lb, err := ln.lc.VerifyLightBlockAtHeight(height, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error verifying validators at height %d: %w", height, err)
+	}
+
+	converted := make([]node.Validator, len(lb.ValidatorSet.Validators))
+	for i, val := range lb.ValidatorSet.Validators {
+		converted[i] = node.Validator{
+			Address:          val.Address.Bytes(),
+			PubKey:           val.PubKey.Bytes(),
+			VotingPower:      val.VotingPower,
+			ProposerPriority: val.ProposerPriority,
+		}
+	}
+
+	return &node.Validators{
+		BlockHeight: height,
+		Validators:  converted,
+		Count:       len(converted),
+		Total:       len(converted),
+	}, nil
+}
+
+// Block implements node.ConsensusClient. The header returned by the primary is verified
+// against the light client's trusted header chain before its associated transactions are
+// trusted.
+func (ln *LightNode) Block(height int64) (*node.Block, error) {
+	lb, err := ln.lc.VerifyLightBlockAtHeight(height, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error verifying header at height %d: %w", height, err)
+	}
+
+	res, err := ln.primary.Block(ln.ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(res.BlockID.Hash, lb.Hash()) {
+		return nil, fmt.Errorf("block hash returned by primary does not match the verified header at height %d", height)
+	}
+
+	return toJunoBlock(res), nil
+}
+
+// BlockResults implements node.ConsensusClient. A block's results are verified by recomputing
+// their hash and matching it against the LastResultsHash of the following (verified) header,
+// exactly as CometBFT commits to them. Only what tmtypes.NewResults hashes of the per-tx
+// results is checked; the begin/end block events, validator updates and consensus param
+// updates are returned as the primary reports them.
+func (ln *LightNode) BlockResults(height int64) (*node.BlockResults, error) {
+	res, err := ln.primary.BlockResults(ln.ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	nextHeader, err := ln.lc.VerifyHeaderAtHeight(height+1, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error verifying results at height %d: %w", height, err)
+	}
+
+	resultsHash := tmtypes.NewResults(res.TxsResults).Hash()
+	if !bytes.Equal(resultsHash, nextHeader.LastResultsHash) {
+		return nil, fmt.Errorf("block results hash does not match the verified header at height %d", height)
+	}
+
+	return toJunoBlockResults(res), nil
+}
+
+// SubscribeEvents implements node.ConsensusClient by proxying directly to the primary node.
+// Callers relying on data derived from the resulting events (e.g. a new block height) should
+// still fetch and verify it through Block/Validators/BlockResults above.
+func (ln *LightNode) SubscribeEvents(ctx context.Context, subscriber, query string) (<-chan node.Event, context.CancelFunc, error) {
+	return subscribeEvents(ctx, ln.ctx, ln.primary, subscriber, query)
+}
+
+// Tx implements node.Node
+func (ln *LightNode) Tx(hash string) (*types.Transaction, error) {
+	return ln.fetchTx(ln.ctx, hash)
+}
+
+// fetchTx fetches a single tx, preferring the gRPC cosmos.tx.v1beta1.Service when configured
+// and falling back to the rate-limited, retrying REST transport otherwise (or if the gRPC call
+// itself fails).
+func (ln *LightNode) fetchTx(ctx context.Context, hash string) (*types.Transaction, error) {
+	if ln.txService.configured() {
+		res, err := ln.GetTx(ctx, hash)
+		if err == nil {
+			return decodeGetTxResponse(res)
+		}
+	}
+
+	return ln.rest.fetchTx(ctx, hash)
+}
+
+// Txs implements node.Node
+// NOTE: DChain first tx is always the verifiable presentation so we do not parse it for now
+func (ln *LightNode) Txs(block *node.Block) ([]*types.Transaction, error) {
+	txResponses := make([]*types.Transaction, len(block.Txs))
+	if len(block.Txs) == 0 {
+		return txResponses, nil
+	}
+
+	vp, err := handleVPTx(block.Txs[0], block, ln.vpVerifier)
+	if err != nil {
+		if ln.haltOnUnverifiableVP {
+			return nil, err
+		}
+	} else {
+		txResponses[0] = vp
+	}
+
+	if len(block.Txs) == 1 {
+		return txResponses, nil
+	}
+
+	hashes := make([]string, len(block.Txs)-1)
+	for i := 1; i < len(block.Txs); i++ {
+		hashes[i-1] = fmt.Sprintf("%X", tmtypes.Tx(block.Txs[i]).Hash())
+	}
+
+	rest, err := fetchBatch(ln.ctx, ln.rest.concurrency, hashes, ln.fetchTx)
+	if err != nil {
+		return nil, err
+	}
+	copy(txResponses[1:], rest)
+
+	return txResponses, nil
+}
+
+// TxSearch implements node.Node
+func (ln *LightNode) TxSearch(query string, page *int, perPage *int, orderBy string) (*node.TxSearchResult, error) {
+	res, err := ln.primary.TxSearch(ln.ctx, query, false, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([][]byte, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = tx.Tx
+	}
+
+	return &node.TxSearchResult{Txs: txs, TotalCount: res.TotalCount}, nil
+}
+
+// Stop implements node.Node
+func (ln *LightNode) Stop() {
+	ln.cancel()
+
+	if err := ln.primary.Stop(); err != nil {
+		panic(fmt.Errorf("error while stopping light client primary: %s", err))
+	}
+
+	if err := ln.txService.Close(); err != nil {
+		panic(fmt.Errorf("error while closing tx service gRPC connection: %s", err))
+	}
+}
+
+// GetTx implements node.TxService
+func (ln *LightNode) GetTx(ctx context.Context, hash string) (*sdktxtypes.GetTxResponse, error) {
+	return ln.txService.GetTx(ctx, hash)
+}
+
+// GetTxsEvent implements node.TxService
+func (ln *LightNode) GetTxsEvent(ctx context.Context, events []string, page, limit uint64) (*sdktxtypes.GetTxsEventResponse, error) {
+	return ln.txService.GetTxsEvent(ctx, events, page, limit)
+}
+
+// Simulate implements node.TxService
+func (ln *LightNode) Simulate(ctx context.Context, txBytes []byte) (*sdktxtypes.SimulateResponse, error) {
+	return ln.txService.Simulate(ctx, txBytes)
+}
+
+// BroadcastTx implements node.TxService
+func (ln *LightNode) BroadcastTx(ctx context.Context, txBytes []byte, mode sdktxtypes.BroadcastMode) (*sdktxtypes.BroadcastTxResponse, error) {
+	return ln.txService.BroadcastTx(ctx, txBytes, mode)
+}
@@ -0,0 +1,218 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+
+	"github.com/forbole/juno/v6/types"
+)
+
+const (
+	// defaultAPIConcurrency is used as restClient's worker pool size when neither
+	// APIConfig.Concurrency nor RPCConfig.MaxConnections are set.
+	defaultAPIConcurrency = 10
+
+	// defaultAPIMaxRetries is used as restClient's retry budget when APIConfig.MaxRetries is
+	// unset.
+	defaultAPIMaxRetries = 3
+
+	// baseRetryBackoff is the backoff delay before the first retry; each subsequent retry
+	// doubles it, plus jitter.
+	baseRetryBackoff = 200 * time.Millisecond
+)
+
+// restClient fetches transactions through the chain SDK REST API, sharing a single
+// connection-reusing *http.Client, rate limiter and retry policy across every fetch a
+// node.Node implementation issues, whether that is a single Tx() lookup or the worker pool
+// backing Txs().
+type restClient struct {
+	httpClient  *http.Client
+	limiter     *rate.Limiter
+	address     string
+	concurrency int
+	maxRetries  int
+}
+
+// newRESTClient builds a restClient for cfg, reusing connections through MaxConnsPerHost and
+// defaulting Concurrency to rpcMaxConnections (the RPC endpoint's own connection limit) when
+// unset, so a node does not need to size the two independently.
+func newRESTClient(cfg APIConfig, rpcMaxConnections int) *restClient {
+	concurrency := cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = rpcMaxConnections
+	}
+	if concurrency <= 0 {
+		concurrency = defaultAPIConcurrency
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultAPIMaxRetries
+	}
+
+	var limiter *rate.Limiter
+	if cfg.RPS > 0 {
+		limiter = rate.NewLimiter(rate.Limit(cfg.RPS), concurrency)
+	}
+
+	return &restClient{
+		httpClient: &http.Client{
+			Transport: &http.Transport{MaxConnsPerHost: concurrency},
+		},
+		limiter:     limiter,
+		address:     cfg.Address,
+		concurrency: concurrency,
+		maxRetries:  maxRetries,
+	}
+}
+
+// fetchTx fetches the tx identified by hash, retrying transient failures with exponential
+// backoff and jitter.
+func (rc *restClient) fetchTx(ctx context.Context, hash string) (*types.Transaction, error) {
+	var lastErr error
+	for attempt := 0; attempt <= rc.maxRetries; attempt++ {
+		if attempt > 0 {
+			if err := sleepBackoff(ctx, attempt); err != nil {
+				return nil, err
+			}
+		}
+
+		if rc.limiter != nil {
+			if err := rc.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		tx, err := rc.doFetchTx(ctx, hash)
+		if err == nil {
+			return tx, nil
+		}
+		lastErr = err
+
+		if !isRetryable(err) {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("exceeded %d retries fetching tx %s: %w", rc.maxRetries, hash, lastErr)
+}
+
+// fetchTxsBatch fetches every tx identified by hashes, spreading the calls over a worker pool
+// sized by rc.concurrency. Results are written into the returned slice at their original
+// index, so ordering is preserved regardless of which worker finishes first. The first
+// non-retryable error cancels every in-flight fetch and is returned.
+func (rc *restClient) fetchTxsBatch(ctx context.Context, hashes []string) ([]*types.Transaction, error) {
+	return fetchBatch(ctx, rc.concurrency, hashes, rc.fetchTx)
+}
+
+// fetchBatch fetches every tx identified by hashes through fetch, spreading the calls over a
+// worker pool sized by concurrency. Results are written into the returned slice at their
+// original index, so ordering is preserved regardless of which worker finishes first. The
+// first non-retryable error cancels every in-flight fetch and is returned. It is shared by
+// restClient.fetchTxsBatch and LightNode.Txs, which additionally tries the tx service gRPC
+// transport before falling back to REST.
+func fetchBatch(ctx context.Context, concurrency int, hashes []string, fetch func(context.Context, string) (*types.Transaction, error)) ([]*types.Transaction, error) {
+	txs := make([]*types.Transaction, len(hashes))
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(concurrency)
+
+	for i, hash := range hashes {
+		i, hash := i, hash
+		group.Go(func() error {
+			tx, err := fetch(ctx, hash)
+			if err != nil {
+				return err
+			}
+			txs[i] = tx
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return txs, nil
+}
+
+// doFetchTx performs the single underlying HTTP call behind fetchTx
+func (rc *restClient) doFetchTx(ctx context.Context, hash string) (*types.Transaction, error) {
+	url := fmt.Sprintf("%s/cosmos/tx/v1beta1/txs/%s", rc.address, hash)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := rc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &httpStatusError{statusCode: resp.StatusCode, body: string(body)}
+	}
+
+	var convTx *types.Transaction
+	if err := json.Unmarshal(body, &convTx); err != nil {
+		return nil, fmt.Errorf("error converting transaction: %s", err.Error())
+	}
+
+	return convTx, nil
+}
+
+// httpStatusError wraps a non-200 HTTP response, so isRetryable can tell a transient 429/5xx
+// apart from a permanent 4xx such as "tx not found".
+type httpStatusError struct {
+	statusCode int
+	body       string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("request failed with status code %d: %s", e.statusCode, e.body)
+}
+
+// isRetryable reports whether err represents a transient failure worth retrying: a 429, a 5xx,
+// or a context deadline exceeded (as opposed to the caller's own context being cancelled).
+func isRetryable(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.statusCode == http.StatusTooManyRequests || statusErr.statusCode >= 500
+	}
+
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// sleepBackoff sleeps for an exponentially increasing, jittered delay before retry attempt
+// (1-indexed), returning ctx.Err() if ctx is done first.
+func sleepBackoff(ctx context.Context, attempt int) error {
+	backoff := baseRetryBackoff * time.Duration(1<<uint(attempt-1))
+	jitter := time.Duration(rand.Int63n(int64(backoff)))
+	delay := backoff + jitter
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,329 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const (
+	// newBlocksQuery is the CometBFT query every ConsensusClient implementation recognizes as
+	// "notify me of newly produced blocks".
+	newBlocksQuery = "tm.event = 'NewBlock'"
+
+	// replayBufferSize is the number of most recently published events a querySubscription
+	// keeps around, so a subscriber that was connected through a reconnect (and may have missed
+	// whatever arrived during the gap) gets a bounded window of recent context replayed to it.
+	replayBufferSize = 16
+
+	// baseReconnectBackoff and maxReconnectBackoff bound the exponential backoff used between
+	// reconnect attempts after the underlying subscription drops.
+	baseReconnectBackoff = 500 * time.Millisecond
+	maxReconnectBackoff  = 30 * time.Second
+)
+
+// SubscriptionManager multiplexes event subscriptions over a single Node. Every caller
+// subscribing to the same query shares one underlying subscription instead of opening its own
+// (CometBFT RPC caps the number of concurrent websocket subscriptions a client may hold,
+// typically to 5), the shared subscription reconnects with capped exponential backoff when the
+// underlying websocket drops, and a bounded replay buffer smooths over short outages so a
+// reconnect does not silently drop events for a subscriber's parser.
+type SubscriptionManager struct {
+	node Node
+
+	mu   sync.Mutex
+	subs map[string]*querySubscription
+}
+
+// NewSubscriptionManager returns a SubscriptionManager multiplexing subscriptions over n.
+func NewSubscriptionManager(n Node) *SubscriptionManager {
+	return &SubscriptionManager{
+		node: n,
+		subs: map[string]*querySubscription{},
+	}
+}
+
+// querySubscription is the single underlying subscription backing every caller subscribed to
+// the same query: it owns the reconnect loop and fans events out to every subscriber channel.
+type querySubscription struct {
+	query string
+
+	mu          sync.Mutex
+	subscribers map[int]chan Event
+	nextID      int
+	buffer      []Event
+
+	// cancel tears down the current underlying node-level subscription (and, transitively, the
+	// reconnect loop once the manager decides no subscriber is left).
+	cancel context.CancelFunc
+}
+
+// subscribe registers a new subscriber channel, replaying the current buffer to it first so it
+// does not start from a blank slate in the middle of an already-running subscription.
+func (qs *querySubscription) subscribe() (chan Event, int) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	id := qs.nextID
+	qs.nextID++
+
+	ch := make(chan Event, replayBufferSize)
+	for _, event := range qs.buffer {
+		ch <- event
+	}
+	qs.subscribers[id] = ch
+
+	return ch, id
+}
+
+// unsubscribe removes and closes the subscriber channel registered under id, returning the
+// number of subscribers left.
+func (qs *querySubscription) unsubscribe(id int) int {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	if ch, ok := qs.subscribers[id]; ok {
+		close(ch)
+		delete(qs.subscribers, id)
+	}
+
+	return len(qs.subscribers)
+}
+
+// publish appends event to the replay buffer and fans it out to every subscriber. A subscriber
+// whose channel is full is skipped for this event rather than blocking every other subscriber,
+// since a buffered channel this size only fills up when that one caller is falling behind.
+func (qs *querySubscription) publish(event Event) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	qs.buffer = append(qs.buffer, event)
+	if len(qs.buffer) > replayBufferSize {
+		qs.buffer = qs.buffer[len(qs.buffer)-replayBufferSize:]
+	}
+
+	for _, ch := range qs.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// replay re-sends the current buffer to every subscriber, used right after a reconnect so a
+// subscriber that was live through a short outage still sees whatever arrived right before the
+// drop, even if its own buffered channel had drained in the meantime.
+func (qs *querySubscription) replay() {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	for _, ch := range qs.buffer {
+		for _, sub := range qs.subscribers {
+			select {
+			case sub <- ch:
+			default:
+			}
+		}
+	}
+}
+
+// subscriberCount returns the number of subscribers currently registered
+func (qs *querySubscription) subscriberCount() int {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	return len(qs.subscribers)
+}
+
+// SubscribeEvents returns a channel of events matching query, sharing the underlying
+// subscription with any other caller already subscribed to the same query. The subscription
+// stays alive, reconnecting with capped exponential backoff on disconnect, for as long as at
+// least one subscriber remains; the returned cancel func only removes this caller, tearing down
+// the shared subscription once it was the last one.
+//
+// ctx bounds the initial dial only, exactly like Node.SubscribeEvents.
+func (m *SubscriptionManager) SubscribeEvents(ctx context.Context, query string) (<-chan Event, context.CancelFunc, error) {
+	var runCtx context.Context
+
+	m.mu.Lock()
+	qs, existed := m.subs[query]
+	if !existed {
+		// cancel is set on qs before qs is published into m.subs, and is never reassigned
+		// afterwards, so every later read of qs.cancel (including from a concurrent
+		// SubscribeEvents call for the same brand-new query) observes it already populated
+		// without needing its own lock.
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithCancel(context.Background())
+		qs = &querySubscription{query: query, subscribers: map[int]chan Event{}, cancel: cancel}
+		m.subs[query] = qs
+	}
+	m.mu.Unlock()
+
+	if !existed {
+		dialed := make(chan error, 1)
+		go m.run(runCtx, qs, dialed)
+
+		select {
+		case <-ctx.Done():
+			qs.cancel()
+			m.mu.Lock()
+			delete(m.subs, query)
+			m.mu.Unlock()
+			return nil, nil, ctx.Err()
+
+		case err := <-dialed:
+			if err != nil {
+				qs.cancel()
+				m.mu.Lock()
+				delete(m.subs, query)
+				m.mu.Unlock()
+				return nil, nil, err
+			}
+		}
+	}
+
+	ch, id := qs.subscribe()
+
+	unsubscribe := func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if qs.unsubscribe(id) == 0 {
+			qs.cancel()
+			delete(m.subs, query)
+		}
+	}
+
+	return ch, unsubscribe, nil
+}
+
+// run owns qs's underlying node-level subscription for as long as ctx is not done: it dials,
+// fans incoming events out to qs's subscribers, and re-dials with capped exponential backoff
+// whenever the underlying stream closes. dialed receives the outcome of the very first dial
+// attempt only, so SubscribeEvents can report an initial failure to its caller.
+func (m *SubscriptionManager) run(ctx context.Context, qs *querySubscription, dialed chan<- error) {
+	backoff := baseReconnectBackoff
+	first := true
+
+	for {
+		eventCh, cancel, err := m.node.SubscribeEvents(ctx, fmt.Sprintf("subscription-manager-%p", qs), qs.query)
+		if first {
+			dialed <- err
+			first = false
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if !sleepBackoff(ctx, &backoff) {
+				return
+			}
+			continue
+		}
+		backoff = baseReconnectBackoff
+
+		qs.replay()
+		m.drain(ctx, qs, eventCh)
+		cancel()
+
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// drain reads eventCh until it closes (the underlying subscription dropped) or ctx is done
+func (m *SubscriptionManager) drain(ctx context.Context, qs *querySubscription, eventCh <-chan Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-eventCh:
+			if !ok {
+				return
+			}
+			qs.publish(event)
+		}
+	}
+}
+
+// sleepBackoff sleeps for *backoff plus jitter, doubling *backoff up to maxReconnectBackoff for
+// next time, and reports whether the sleep completed (false if ctx ended first).
+func sleepBackoff(ctx context.Context, backoff *time.Duration) bool {
+	delay := *backoff + time.Duration(rand.Int63n(int64(*backoff)))
+
+	*backoff *= 2
+	if *backoff > maxReconnectBackoff {
+		*backoff = maxReconnectBackoff
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// SubscribeNewBlocks subscribes to newly produced blocks, fetching and decoding each one on a
+// goroutine of its own rather than the caller's, so a caller only ever sees fully formed
+// *Block values. It uses the NewBlock event subscription purely as a wake-up signal and fetches
+// every height between the last one handed out and the chain's current tip through Node.Block,
+// which keeps the output correct even if an individual notification is missed or coalesced
+// (e.g. right after a reconnect). A block is checked only as far as Node.Block itself checks
+// it; no further validation is applied here.
+func (m *SubscriptionManager) SubscribeNewBlocks(ctx context.Context) (<-chan *Block, context.CancelFunc, error) {
+	wake, cancelWake, err := m.SubscribeEvents(ctx, newBlocksQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make(chan *Block)
+	runCtx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		defer close(out)
+		defer cancelWake()
+
+		lastHeight := int64(-1)
+		for {
+			select {
+			case <-runCtx.Done():
+				return
+
+			case _, ok := <-wake:
+				if !ok {
+					return
+				}
+
+				latest, err := m.node.LatestHeight()
+				if err != nil {
+					continue
+				}
+				if lastHeight < 0 {
+					lastHeight = latest - 1
+				}
+
+				for height := lastHeight + 1; height <= latest; height++ {
+					block, err := m.node.Block(height)
+					if err != nil {
+						break
+					}
+
+					select {
+					case out <- block:
+						lastHeight = height
+					case <-runCtx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, cancel, nil
+}
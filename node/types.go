@@ -0,0 +1,109 @@
+package node
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUnsupported is returned by a ConsensusClient implementation when it is asked to perform a
+// query that it has no way of fulfilling (for example because the underlying consensus engine
+// does not expose that data).
+var ErrUnsupported = errors.New("operation not supported by this consensus client")
+
+// ErrUnsupportedByLightNode is returned by a light-client backed ConsensusClient when asked for
+// data that cannot be cryptographically verified against its trusted header chain (e.g. the
+// live consensus round state, which has no commit to check against).
+var ErrUnsupportedByLightNode = errors.New("operation not supported when running against a light client: result cannot be verified")
+
+// BlockID uniquely identifies a Block by its hash
+type BlockID struct {
+	Hash []byte
+}
+
+// Header contains the header fields of a Block that Juno relies on while indexing
+type Header struct {
+	ChainID         string
+	Height          int64
+	Time            time.Time
+	ProposerAddress []byte
+}
+
+// Block represents a single, chain-agnostic block together with the transactions it contains
+type Block struct {
+	ID     BlockID
+	Header Header
+	Txs    [][]byte
+}
+
+// BlockResults represents the outcome of executing a block: the events and responses produced
+// by the begin-block, end-block and per-transaction execution steps
+type BlockResults struct {
+	Height                int64
+	TxsResults            []TxResult
+	BeginBlockEvents      []Event
+	EndBlockEvents        []Event
+	ValidatorUpdates      []Validator
+	ConsensusParamUpdates *ConsensusParams
+}
+
+// TxResult represents the execution outcome of a single transaction within a block
+type TxResult struct {
+	Code      uint32
+	Log       string
+	GasWanted int64
+	GasUsed   int64
+	Events    []Event
+}
+
+// Event represents a single, chain-agnostic event emitted during block or transaction execution
+type Event struct {
+	Type       string
+	Attributes []EventAttribute
+}
+
+// EventAttribute represents a single key/value pair attached to an Event
+type EventAttribute struct {
+	Key   string
+	Value string
+}
+
+// ConsensusParams represents the subset of consensus parameters Juno cares about
+type ConsensusParams struct {
+	MaxBlockBytes int64
+	MaxBlockGas   int64
+}
+
+// Validator represents a single validator taking part in consensus
+type Validator struct {
+	Address          []byte
+	PubKey           []byte
+	VotingPower      int64
+	ProposerPriority int64
+}
+
+// Validators represents the full validator set active at a given height
+type Validators struct {
+	BlockHeight int64
+	Validators  []Validator
+	Count       int
+	Total       int
+}
+
+// ConsensusState represents a simplified view of the node's live consensus round state
+type ConsensusState struct {
+	HeightRoundStep string
+	Proposer        string
+}
+
+// Genesis represents the genesis document of the chain, as raw JSON bytes so that callers can
+// decode it using whatever app-specific genesis type they need
+type Genesis struct {
+	ChainID     string
+	GenesisJSON []byte
+}
+
+// TxSearchResult represents the outcome of a TxSearch query
+type TxSearchResult struct {
+	Txs        [][]byte
+	TotalCount int
+}
@@ -0,0 +1,77 @@
+package vp
+
+import "time"
+
+// Config controls how Verifier checks an incoming SD-JWT verifiable presentation before its
+// disclosed claims are indexed.
+type Config struct {
+	// TrustedIssuers is the allowlist of issuer DIDs a presentation's issuer-signed JWT may
+	// come from. A presentation whose `iss` claim is not in this list is rejected with
+	// ErrUntrustedIssuer. Empty means no issuer is trusted.
+	TrustedIssuers []string
+
+	// SupportedAlgorithms restricts the `alg` the issuer-signed JWT and key-binding JWT may
+	// use, guarding against an attacker-chosen "none" or otherwise weak algorithm. Defaults to
+	// {"ES256", "EdDSA"} when left empty.
+	SupportedAlgorithms []string
+
+	// Strict, when true, also rejects a presentation whose issuer payload `_sd` array
+	// contains a digest with no matching disclosure. When false (the default), such unused
+	// digests are silently ignored, since the holder is allowed to withhold disclosures.
+	Strict bool
+
+	// RequireHolderBinding, when true, rejects a presentation that does not carry a
+	// key-binding JWT.
+	RequireHolderBinding bool
+
+	// ExpectedAudience is the `aud` value the key-binding JWT must carry. Required whenever a
+	// key-binding JWT is present or RequireHolderBinding is set.
+	ExpectedAudience string
+
+	// ExpectedNonce is the `nonce` value the key-binding JWT must carry.
+	ExpectedNonce string
+
+	// ClockSkew bounds how far the key-binding JWT's `iat` may drift from the current time.
+	// Defaults to 5 minutes when zero.
+	ClockSkew time.Duration
+}
+
+// defaultSupportedAlgorithms is used whenever Config.SupportedAlgorithms is left empty.
+var defaultSupportedAlgorithms = []string{"ES256", "EdDSA"}
+
+// algorithms returns the configured SupportedAlgorithms, falling back to
+// defaultSupportedAlgorithms when unset.
+func (c Config) algorithms() []string {
+	if len(c.SupportedAlgorithms) == 0 {
+		return defaultSupportedAlgorithms
+	}
+	return c.SupportedAlgorithms
+}
+
+// clockSkew returns the configured ClockSkew, falling back to 5 minutes when unset.
+func (c Config) clockSkew() time.Duration {
+	if c.ClockSkew == 0 {
+		return 5 * time.Minute
+	}
+	return c.ClockSkew
+}
+
+// algorithmAllowed reports whether alg is one of the configured SupportedAlgorithms.
+func (c Config) algorithmAllowed(alg string) bool {
+	for _, a := range c.algorithms() {
+		if a == alg {
+			return true
+		}
+	}
+	return false
+}
+
+// isTrustedIssuer reports whether issuer is present in TrustedIssuers.
+func (c Config) isTrustedIssuer(issuer string) bool {
+	for _, trusted := range c.TrustedIssuers {
+		if trusted == issuer {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package vp
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// decodeBase64URL decodes an unpadded base64url string, the encoding used throughout JWTs and
+// SD-JWT disclosures.
+func decodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+// base58Alphabet is the Bitcoin/IPFS base58btc alphabet used to encode did:key identifiers.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// decodeBase58 decodes a base58btc-encoded string, as used by the "z" multibase prefix.
+func decodeBase58(s string) ([]byte, error) {
+	result := make([]byte, 0, len(s))
+	for _, c := range s {
+		digit := -1
+		for i, a := range base58Alphabet {
+			if a == c {
+				digit = i
+				break
+			}
+		}
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", c)
+		}
+
+		carry := digit
+		for i := 0; i < len(result); i++ {
+			carry += int(result[i]) * 58
+			result[i] = byte(carry & 0xff)
+			carry >>= 8
+		}
+		for carry > 0 {
+			result = append(result, byte(carry&0xff))
+			carry >>= 8
+		}
+	}
+
+	// Leading '1's encode leading zero bytes
+	for _, c := range s {
+		if c != '1' {
+			break
+		}
+		result = append(result, 0)
+	}
+
+	// result was built little-endian
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package vp
+
+import "errors"
+
+// Verification errors returned by Verifier.Verify, wrapped so callers can tell failure kinds
+// apart with errors.Is. The node/remote parser itself does not look at the kind: it skips or
+// halts on any of them according to VPConfig.HaltOnUnverifiable.
+var (
+	// ErrUntrustedIssuer is returned when the presentation's issuer is not part of the
+	// configured trusted-issuer allowlist
+	ErrUntrustedIssuer = errors.New("vp: issuer is not in the trusted issuer allowlist")
+
+	// ErrInvalidIssuerSignature is returned when the issuer-signed JWT is malformed, uses an
+	// algorithm outside Config.SupportedAlgorithms, or its signature does not verify against
+	// the key resolved for its issuer/kid
+	ErrInvalidIssuerSignature = errors.New("vp: invalid issuer signature")
+
+	// ErrDisclosureMismatch is returned when a disclosure is not a well-formed
+	// `[salt, name, value]` array, when its digest cannot be found in the issuer payload's
+	// `_sd` array, or (in strict mode) when a `_sd` digest has no matching disclosure
+	ErrDisclosureMismatch = errors.New("vp: disclosure does not match issuer payload digests")
+
+	// ErrInvalidKeyBinding is returned when a presentation carries a key-binding JWT that
+	// fails to verify against the `cnf.jwk` of the issuer payload, or whose aud/nonce/iat
+	// fall outside the accepted bounds
+	ErrInvalidKeyBinding = errors.New("vp: invalid holder key-binding JWT")
+
+	// ErrUnresolvableIssuer is returned when the configured DIDResolver cannot resolve a
+	// verification key for the presentation's issuer
+	ErrUnresolvableIssuer = errors.New("vp: unable to resolve issuer verification key")
+)
@@ -0,0 +1,229 @@
+package vp
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DIDResolver resolves the public key that verifies JWTs issued by a given DID, so Verifier
+// never needs to know how a particular DID method publishes its keys.
+type DIDResolver interface {
+	// ResolveKey returns the public key of issuer's verification method identified by kid. An
+	// empty kid resolves to the DID's sole verification method, returning an error if there is
+	// more than one.
+	ResolveKey(issuer, kid string) (crypto.PublicKey, error)
+}
+
+// didDocument is the minimal subset of a W3C DID document this package needs: enough
+// verification methods to resolve a JWK by id.
+type didDocument struct {
+	VerificationMethod []verificationMethod `json:"verificationMethod"`
+}
+
+type verificationMethod struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	PublicKeyJwk *jwk   `json:"publicKeyJwk"`
+}
+
+// publicKey converts the verification method's JWK into a crypto.PublicKey
+func (vm verificationMethod) publicKey() (crypto.PublicKey, error) {
+	if vm.PublicKeyJwk == nil {
+		return nil, fmt.Errorf("%w: verification method %q has no publicKeyJwk", ErrUnresolvableIssuer, vm.ID)
+	}
+	return vm.PublicKeyJwk.publicKey()
+}
+
+// resolveMethod picks the verification method matching kid out of methods, defaulting to the
+// sole method when kid is empty and there is exactly one.
+func resolveMethod(did, kid string, methods []verificationMethod) (crypto.PublicKey, error) {
+	if kid == "" {
+		if len(methods) != 1 {
+			return nil, fmt.Errorf("%w: %q exposes %d verification methods, a kid is required", ErrUnresolvableIssuer, did, len(methods))
+		}
+		return methods[0].publicKey()
+	}
+
+	for _, vm := range methods {
+		if vm.ID == kid || strings.HasSuffix(vm.ID, "#"+kid) {
+			return vm.publicKey()
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q has no verification method matching kid %q", ErrUnresolvableIssuer, did, kid)
+}
+
+// WebResolver resolves did:web issuer DIDs by fetching their DID document over HTTPS, following
+// the did:web method spec (https://w3c-ccg.github.io/did-method-web/).
+type WebResolver struct {
+	Client *http.Client
+}
+
+// NewWebResolver returns a WebResolver using a 10 second HTTP timeout.
+func NewWebResolver() *WebResolver {
+	return &WebResolver{Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// ResolveKey implements DIDResolver
+func (r *WebResolver) ResolveKey(issuer, kid string) (crypto.PublicKey, error) {
+	url, err := didWebURL(issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.Client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%w: error fetching %q: %s", ErrUnresolvableIssuer, url, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: error reading %q: %s", ErrUnresolvableIssuer, url, err)
+	}
+
+	var doc didDocument
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("%w: error decoding DID document at %q: %s", ErrUnresolvableIssuer, url, err)
+	}
+
+	return resolveMethod(issuer, kid, doc.VerificationMethod)
+}
+
+// didWebURL converts a did:web identifier into the HTTPS URL its DID document is published at.
+func didWebURL(did string) (string, error) {
+	parts := strings.Split(did, ":")
+	if len(parts) < 3 || parts[0] != "did" || parts[1] != "web" {
+		return "", fmt.Errorf("%w: %q is not a did:web identifier", ErrUnresolvableIssuer, did)
+	}
+
+	domain := strings.Replace(parts[2], "%3A", ":", 1)
+	path := strings.Join(parts[3:], "/")
+	if path == "" {
+		return fmt.Sprintf("https://%s/.well-known/did.json", domain), nil
+	}
+	return fmt.Sprintf("https://%s/%s/did.json", domain, path), nil
+}
+
+// KeyResolver resolves did:key issuer DIDs directly from the multibase-encoded public key
+// embedded in the DID itself, requiring no network access.
+type KeyResolver struct{}
+
+// NewKeyResolver returns a KeyResolver
+func NewKeyResolver() *KeyResolver {
+	return &KeyResolver{}
+}
+
+// ResolveKey implements DIDResolver
+func (r *KeyResolver) ResolveKey(issuer, _ string) (crypto.PublicKey, error) {
+	const didKeyPrefix = "did:key:"
+	if !strings.HasPrefix(issuer, didKeyPrefix) {
+		return nil, fmt.Errorf("%w: %q is not a did:key identifier", ErrUnresolvableIssuer, issuer)
+	}
+
+	multibaseValue := strings.TrimPrefix(issuer, didKeyPrefix)
+	if len(multibaseValue) == 0 || multibaseValue[0] != 'z' {
+		return nil, fmt.Errorf("%w: %q is not base58btc multibase-encoded", ErrUnresolvableIssuer, issuer)
+	}
+
+	decoded, err := decodeBase58(multibaseValue[1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: error decoding %q: %s", ErrUnresolvableIssuer, issuer, err)
+	}
+
+	// Multicodec varint prefix: 0xed01 identifies an Ed25519 public key, the only key type
+	// did:key is expected to produce for SD-JWT issuers today.
+	if len(decoded) < 2 || decoded[0] != 0xed || decoded[1] != 0x01 {
+		return nil, fmt.Errorf("%w: %q uses an unsupported did:key key type", ErrUnresolvableIssuer, issuer)
+	}
+
+	return ed25519.PublicKey(decoded[2:]), nil
+}
+
+// MultiResolver dispatches ResolveKey to the DIDResolver registered for a DID's method,
+// returning ErrUnresolvableIssuer for methods with no registered resolver.
+type MultiResolver struct {
+	resolvers map[string]DIDResolver
+}
+
+// NewMultiResolver returns a MultiResolver pre-populated with the default "web" and "key"
+// method resolvers.
+func NewMultiResolver() *MultiResolver {
+	return &MultiResolver{
+		resolvers: map[string]DIDResolver{
+			"web": NewWebResolver(),
+			"key": NewKeyResolver(),
+		},
+	}
+}
+
+// Register adds (or replaces) the DIDResolver used for the given DID method (e.g. "web").
+func (r *MultiResolver) Register(method string, resolver DIDResolver) {
+	r.resolvers[method] = resolver
+}
+
+// ResolveKey implements DIDResolver
+func (r *MultiResolver) ResolveKey(issuer, kid string) (crypto.PublicKey, error) {
+	parts := strings.SplitN(issuer, ":", 3)
+	if len(parts) < 3 || parts[0] != "did" {
+		return nil, fmt.Errorf("%w: %q is not a valid DID", ErrUnresolvableIssuer, issuer)
+	}
+
+	resolver, ok := r.resolvers[parts[1]]
+	if !ok {
+		return nil, fmt.Errorf("%w: no resolver registered for did method %q", ErrUnresolvableIssuer, parts[1])
+	}
+
+	return resolver.ResolveKey(issuer, kid)
+}
+
+// jwk is the minimal subset of RFC 7517 needed to rebuild the public keys used by SD-JWT
+// issuers and holders (EC P-256 and OKP Ed25519).
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey converts k into a crypto.PublicKey
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	x, err := decodeBase64URL(k.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwk x: %w", err)
+	}
+
+	switch k.Kty {
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported OKP curve %q", k.Crv)
+		}
+		return ed25519.PublicKey(x), nil
+
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported EC curve %q", k.Crv)
+		}
+		y, err := decodeBase64URL(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwk y: %w", err)
+		}
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported jwk kty %q", k.Kty)
+	}
+}
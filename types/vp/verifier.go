@@ -0,0 +1,289 @@
+package vp
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	sdwjt "github.com/hyperledger/aries-framework-go/component/models/sdjwt/common"
+)
+
+// Verifier checks the issuer signature, SD hash-to-disclosure binding and optional holder key
+// binding of an SD-JWT verifiable presentation before its disclosed claims may be trusted.
+type Verifier struct {
+	cfg      Config
+	resolver DIDResolver
+}
+
+// NewVerifier returns a Verifier that resolves issuer and holder keys through resolver and
+// enforces cfg.
+func NewVerifier(cfg Config, resolver DIDResolver) *Verifier {
+	return &Verifier{cfg: cfg, resolver: resolver}
+}
+
+// Verify parses combined as an SD-JWT combined presentation, verifies the issuer signature,
+// the SD hash-to-disclosure binding and (if present, or required by Config) the holder
+// key-binding JWT, and returns the disclosed claims on success.
+//
+// On failure it returns one of the sentinel errors declared in errors.go, so callers can
+// decide whether to skip the presentation or halt indexing based on Config and the error kind.
+func (v *Verifier) Verify(combined string) (map[string]interface{}, error) {
+	parsed := sdwjt.ParseCombinedFormatForPresentation(strings.TrimSpace(combined))
+
+	issuerHeader, issuerPayload, signingInput, signature, err := decodeJWT(parsed.SDJWT)
+	if err != nil {
+		return nil, fmt.Errorf("%w: error decoding issuer JWT: %s", ErrInvalidIssuerSignature, err)
+	}
+
+	alg, _ := issuerHeader["alg"].(string)
+	if !v.cfg.algorithmAllowed(alg) {
+		return nil, fmt.Errorf("%w: algorithm %q is not allowed", ErrInvalidIssuerSignature, alg)
+	}
+
+	issuer, _ := issuerPayload["iss"].(string)
+	if !v.cfg.isTrustedIssuer(issuer) {
+		return nil, fmt.Errorf("%w: %q", ErrUntrustedIssuer, issuer)
+	}
+
+	kid, _ := issuerHeader["kid"].(string)
+	issuerKey, err := v.resolver.ResolveKey(issuer, kid)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnresolvableIssuer, err)
+	}
+
+	if err := verifySignature(issuerKey, alg, signingInput, signature); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIssuerSignature, err)
+	}
+
+	disclosedJson, err := v.verifyDisclosures(issuerPayload, parsed.Disclosures)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.verifyHolderBinding(issuerPayload, parsed.HolderVerification); err != nil {
+		return nil, err
+	}
+
+	return disclosedJson, nil
+}
+
+// verifyDisclosures recomputes the SHA-256 digest of every disclosure, matches it against the
+// `_sd` digests found anywhere in issuerPayload and, on success, returns the disclosed claims
+// keyed by their plaintext claim name. In Config.Strict mode it also rejects a presentation
+// that leaves any `_sd` digest without a matching disclosure.
+func (v *Verifier) verifyDisclosures(issuerPayload map[string]interface{}, disclosures []string) (map[string]interface{}, error) {
+	digests := collectSDDigests(issuerPayload)
+	matched := map[string]bool{}
+	disclosedJson := map[string]interface{}{}
+
+	for _, disclosure := range disclosures {
+		digest := sdDigest(disclosure)
+		if !digests[digest] {
+			return nil, fmt.Errorf("%w: disclosure digest %q not present in issuer payload", ErrDisclosureMismatch, digest)
+		}
+		matched[digest] = true
+
+		decoded, err := decodeBase64URL(disclosure)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to decode disclosure: %s", ErrDisclosureMismatch, err)
+		}
+		var disclosureArr []interface{}
+		if err := json.Unmarshal(decoded, &disclosureArr); err != nil {
+			return nil, fmt.Errorf("%w: failed to unmarshal disclosure array: %s", ErrDisclosureMismatch, err)
+		}
+		if len(disclosureArr) != 3 {
+			return nil, fmt.Errorf("%w: disclosure array has %d elements, expected 3", ErrDisclosureMismatch, len(disclosureArr))
+		}
+		name, ok := disclosureArr[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: disclosure claim name is not a string", ErrDisclosureMismatch)
+		}
+		disclosedJson[name] = disclosureArr[2]
+	}
+
+	if v.cfg.Strict {
+		for digest := range digests {
+			if !matched[digest] {
+				return nil, fmt.Errorf("%w: _sd digest %q has no matching disclosure", ErrDisclosureMismatch, digest)
+			}
+		}
+	}
+
+	return disclosedJson, nil
+}
+
+// verifyHolderBinding verifies kbJWT (when present) against the `cnf.jwk` confirmation key
+// carried in issuerPayload, checking its aud, nonce and iat. An absent kbJWT is only an error
+// when Config.RequireHolderBinding is set.
+func (v *Verifier) verifyHolderBinding(issuerPayload map[string]interface{}, kbJWT string) error {
+	if kbJWT == "" {
+		if v.cfg.RequireHolderBinding {
+			return fmt.Errorf("%w: presentation is missing the required key-binding JWT", ErrInvalidKeyBinding)
+		}
+		return nil
+	}
+
+	cnf, _ := issuerPayload["cnf"].(map[string]interface{})
+	jwkRaw, _ := cnf["jwk"].(map[string]interface{})
+	if jwkRaw == nil {
+		return fmt.Errorf("%w: issuer payload has no cnf.jwk to bind the presentation to", ErrInvalidKeyBinding)
+	}
+
+	jwkBytes, err := json.Marshal(jwkRaw)
+	if err != nil {
+		return fmt.Errorf("%w: error re-marshalling cnf.jwk: %s", ErrInvalidKeyBinding, err)
+	}
+	var holderJwk jwk
+	if err := json.Unmarshal(jwkBytes, &holderJwk); err != nil {
+		return fmt.Errorf("%w: error decoding cnf.jwk: %s", ErrInvalidKeyBinding, err)
+	}
+	holderKey, err := holderJwk.publicKey()
+	if err != nil {
+		return fmt.Errorf("%w: error decoding cnf.jwk: %s", ErrInvalidKeyBinding, err)
+	}
+
+	kbHeader, kbPayload, signingInput, signature, err := decodeJWT(kbJWT)
+	if err != nil {
+		return fmt.Errorf("%w: error decoding key-binding JWT: %s", ErrInvalidKeyBinding, err)
+	}
+
+	alg, _ := kbHeader["alg"].(string)
+	if !v.cfg.algorithmAllowed(alg) {
+		return fmt.Errorf("%w: key-binding algorithm %q is not allowed", ErrInvalidKeyBinding, alg)
+	}
+
+	if err := verifySignature(holderKey, alg, signingInput, signature); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidKeyBinding, err)
+	}
+
+	if aud, _ := kbPayload["aud"].(string); aud != v.cfg.ExpectedAudience {
+		return fmt.Errorf("%w: unexpected aud %q", ErrInvalidKeyBinding, aud)
+	}
+	if nonce, _ := kbPayload["nonce"].(string); nonce != v.cfg.ExpectedNonce {
+		return fmt.Errorf("%w: unexpected nonce %q", ErrInvalidKeyBinding, nonce)
+	}
+
+	iat, _ := kbPayload["iat"].(float64)
+	issuedAt := time.Unix(int64(iat), 0)
+	skew := v.cfg.clockSkew()
+	if now := time.Now(); issuedAt.After(now.Add(skew)) || issuedAt.Before(now.Add(-skew)) {
+		return fmt.Errorf("%w: iat %s is outside the allowed clock skew of %s", ErrInvalidKeyBinding, issuedAt, skew)
+	}
+
+	return nil
+}
+
+// collectSDDigests walks payload (recursing into nested maps and slices, since a `_sd` array
+// may appear at any depth of a selectively-disclosable SD-JWT) and returns the set of every
+// digest it finds.
+func collectSDDigests(payload map[string]interface{}) map[string]bool {
+	digests := map[string]bool{}
+
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+		switch v := node.(type) {
+		case map[string]interface{}:
+			if sd, ok := v["_sd"].([]interface{}); ok {
+				for _, d := range sd {
+					if s, ok := d.(string); ok {
+						digests[s] = true
+					}
+				}
+			}
+			for key, value := range v {
+				if key == "_sd" {
+					continue
+				}
+				walk(value)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(payload)
+
+	return digests
+}
+
+// sdDigest recomputes the base64url-encoded SHA-256 digest of a base64url-encoded disclosure,
+// exactly as specified by the SD-JWT `_sd` hash-to-disclosure binding.
+func sdDigest(disclosure string) string {
+	sum := sha256.Sum256([]byte(disclosure))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// decodeJWT splits a compact JWT into its decoded header, decoded payload, signing input
+// (the "header.payload" the signature is computed over) and raw signature bytes, without
+// verifying the signature.
+func decodeJWT(token string) (header, payload map[string]interface{}, signingInput string, signature []byte, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, nil, "", nil, fmt.Errorf("expected 3 dot-separated JWT segments, got %d", len(parts))
+	}
+
+	headerBytes, err := decodeBase64URL(parts[0])
+	if err != nil {
+		return nil, nil, "", nil, fmt.Errorf("invalid JWT header: %w", err)
+	}
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, nil, "", nil, fmt.Errorf("invalid JWT header: %w", err)
+	}
+
+	payloadBytes, err := decodeBase64URL(parts[1])
+	if err != nil {
+		return nil, nil, "", nil, fmt.Errorf("invalid JWT payload: %w", err)
+	}
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		return nil, nil, "", nil, fmt.Errorf("invalid JWT payload: %w", err)
+	}
+
+	signature, err = decodeBase64URL(parts[2])
+	if err != nil {
+		return nil, nil, "", nil, fmt.Errorf("invalid JWT signature: %w", err)
+	}
+
+	return header, payload, parts[0] + "." + parts[1], signature, nil
+}
+
+// verifySignature verifies signature over signingInput using pubKey, supporting the
+// algorithms SD-JWT issuers and holders are expected to use (ES256 and EdDSA).
+func verifySignature(pubKey interface{}, alg, signingInput string, signature []byte) error {
+	switch alg {
+	case "EdDSA":
+		key, ok := pubKey.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("key is not an Ed25519 key, cannot verify EdDSA signature")
+		}
+		if !ed25519.Verify(key, []byte(signingInput), signature) {
+			return fmt.Errorf("signature verification failed")
+		}
+		return nil
+
+	case "ES256":
+		key, ok := pubKey.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key is not an EC key, cannot verify ES256 signature")
+		}
+		if len(signature) != 64 {
+			return fmt.Errorf("ES256 signature must be 64 bytes, got %d", len(signature))
+		}
+		sum := sha256.Sum256([]byte(signingInput))
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(key, sum[:], r, s) {
+			return fmt.Errorf("signature verification failed")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+}
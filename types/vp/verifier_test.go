@@ -0,0 +1,247 @@
+package vp
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// stubResolver resolves keys from an in-memory map keyed by "issuer#kid", so tests never touch
+// the network.
+type stubResolver struct {
+	keys map[string]crypto.PublicKey
+}
+
+func (r *stubResolver) ResolveKey(issuer, kid string) (crypto.PublicKey, error) {
+	key, ok := r.keys[issuer+"#"+kid]
+	if !ok {
+		return nil, fmt.Errorf("no key registered for %s#%s", issuer, kid)
+	}
+	return key, nil
+}
+
+// signES256 builds a compact JWT "header.payload.signature" over header and payload, signed
+// with priv using ES256 (raw, fixed-size r||s, as verifySignature expects).
+func signES256(t *testing.T, priv *ecdsa.PrivateKey, header, payload map[string]interface{}) string {
+	t.Helper()
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		t.Fatalf("marshal header: %v", err)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(payloadJSON)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, priv, digest[:])
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// buildDisclosure returns the base64url-encoded `[salt, name, value]` disclosure together with
+// the `_sd` digest it matches, exactly as an issuer would compute it.
+func buildDisclosure(salt, name string, value interface{}) (disclosure, digest string) {
+	arr, _ := json.Marshal([]interface{}{salt, name, value})
+	disclosure = base64.RawURLEncoding.EncodeToString(arr)
+	return disclosure, sdDigest(disclosure)
+}
+
+func newECKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return priv
+}
+
+// combine assembles the SD-JWT combined presentation format: the issuer JWT, followed by each
+// disclosure, followed by an (optionally empty) key-binding JWT, all separated by "~".
+func combine(issuerJWT string, disclosures []string, kbJWT string) string {
+	return strings.Join(append(append([]string{issuerJWT}, disclosures...), kbJWT), "~")
+}
+
+func TestVerifier_AcceptsValidPresentation(t *testing.T) {
+	issuer := "did:web:issuer.example"
+	kid := "key-1"
+	issuerKey := newECKey(t)
+
+	disclosure, digest := buildDisclosure("saltsaltsalt", "given_name", "Alice")
+
+	issuerJWT := signES256(t, issuerKey,
+		map[string]interface{}{"alg": "ES256", "kid": kid},
+		map[string]interface{}{"iss": issuer, "_sd": []interface{}{digest}},
+	)
+
+	resolver := &stubResolver{keys: map[string]crypto.PublicKey{issuer + "#" + kid: &issuerKey.PublicKey}}
+	verifier := NewVerifier(Config{TrustedIssuers: []string{issuer}}, resolver)
+
+	claims, err := verifier.Verify(combine(issuerJWT, []string{disclosure}, ""))
+	if err != nil {
+		t.Fatalf("Verify() returned unexpected error: %v", err)
+	}
+	if claims["given_name"] != "Alice" {
+		t.Fatalf("expected disclosed claim given_name=Alice, got %v", claims)
+	}
+}
+
+func TestVerifier_RejectsTamperedDisclosure(t *testing.T) {
+	issuer := "did:web:issuer.example"
+	kid := "key-1"
+	issuerKey := newECKey(t)
+
+	_, digest := buildDisclosure("saltsaltsalt", "given_name", "Alice")
+	tampered, _ := buildDisclosure("saltsaltsalt", "given_name", "Mallory")
+
+	issuerJWT := signES256(t, issuerKey,
+		map[string]interface{}{"alg": "ES256", "kid": kid},
+		map[string]interface{}{"iss": issuer, "_sd": []interface{}{digest}},
+	)
+
+	resolver := &stubResolver{keys: map[string]crypto.PublicKey{issuer + "#" + kid: &issuerKey.PublicKey}}
+	verifier := NewVerifier(Config{TrustedIssuers: []string{issuer}}, resolver)
+
+	_, err := verifier.Verify(combine(issuerJWT, []string{tampered}, ""))
+	if err == nil {
+		t.Fatal("expected an error for a disclosure not matching any _sd digest, got nil")
+	}
+	if !strings.Contains(err.Error(), ErrDisclosureMismatch.Error()) {
+		t.Fatalf("expected error to wrap ErrDisclosureMismatch, got: %v", err)
+	}
+}
+
+func TestVerifier_RejectsAlgNone(t *testing.T) {
+	issuer := "did:web:issuer.example"
+
+	header, _ := json.Marshal(map[string]interface{}{"alg": "none"})
+	payload, _ := json.Marshal(map[string]interface{}{"iss": issuer})
+	issuerJWT := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+
+	resolver := &stubResolver{keys: map[string]crypto.PublicKey{}}
+	verifier := NewVerifier(Config{TrustedIssuers: []string{issuer}}, resolver)
+
+	_, err := verifier.Verify(combine(issuerJWT, nil, ""))
+	if err == nil {
+		t.Fatal("expected alg \"none\" to be rejected, got nil error")
+	}
+	if !strings.Contains(err.Error(), ErrInvalidIssuerSignature.Error()) {
+		t.Fatalf("expected error to wrap ErrInvalidIssuerSignature, got: %v", err)
+	}
+}
+
+func TestVerifier_RejectsUntrustedIssuer(t *testing.T) {
+	issuer := "did:web:issuer.example"
+	kid := "key-1"
+	issuerKey := newECKey(t)
+
+	issuerJWT := signES256(t, issuerKey,
+		map[string]interface{}{"alg": "ES256", "kid": kid},
+		map[string]interface{}{"iss": issuer},
+	)
+
+	resolver := &stubResolver{keys: map[string]crypto.PublicKey{issuer + "#" + kid: &issuerKey.PublicKey}}
+	verifier := NewVerifier(Config{TrustedIssuers: []string{"did:web:someone-else.example"}}, resolver)
+
+	_, err := verifier.Verify(combine(issuerJWT, nil, ""))
+	if err == nil {
+		t.Fatal("expected an untrusted issuer to be rejected, got nil")
+	}
+	if !strings.Contains(err.Error(), ErrUntrustedIssuer.Error()) {
+		t.Fatalf("expected error to wrap ErrUntrustedIssuer, got: %v", err)
+	}
+}
+
+func TestVerifier_RejectsUnresolvableKid(t *testing.T) {
+	issuer := "did:web:issuer.example"
+	issuerKey := newECKey(t)
+
+	issuerJWT := signES256(t, issuerKey,
+		map[string]interface{}{"alg": "ES256", "kid": "unknown-key"},
+		map[string]interface{}{"iss": issuer},
+	)
+
+	// resolver only knows about "key-1", not "unknown-key"
+	resolver := &stubResolver{keys: map[string]crypto.PublicKey{issuer + "#key-1": &issuerKey.PublicKey}}
+	verifier := NewVerifier(Config{TrustedIssuers: []string{issuer}}, resolver)
+
+	_, err := verifier.Verify(combine(issuerJWT, nil, ""))
+	if err == nil {
+		t.Fatal("expected an unresolvable kid to be rejected, got nil")
+	}
+	if !strings.Contains(err.Error(), ErrUnresolvableIssuer.Error()) {
+		t.Fatalf("expected error to wrap ErrUnresolvableIssuer, got: %v", err)
+	}
+}
+
+func TestVerifier_RejectsExpiredKeyBinding(t *testing.T) {
+	issuer := "did:web:issuer.example"
+	kid := "key-1"
+	issuerKey := newECKey(t)
+	holderKey := newECKey(t)
+
+	issuerJWT := signES256(t, issuerKey,
+		map[string]interface{}{"alg": "ES256", "kid": kid},
+		map[string]interface{}{"iss": issuer, "cnf": map[string]interface{}{"jwk": ecJWK(&holderKey.PublicKey)}},
+	)
+
+	kbJWT := signES256(t, holderKey,
+		map[string]interface{}{"alg": "ES256"},
+		map[string]interface{}{
+			"aud":   "verifier.example",
+			"nonce": "n-0S6_WzA2Mj",
+			// Far outside the default 5 minute clock skew, simulating a replayed, stale
+			// key-binding JWT.
+			"iat": float64(1000),
+		},
+	)
+
+	resolver := &stubResolver{keys: map[string]crypto.PublicKey{issuer + "#" + kid: &issuerKey.PublicKey}}
+	verifier := NewVerifier(Config{
+		TrustedIssuers:       []string{issuer},
+		RequireHolderBinding: true,
+		ExpectedAudience:     "verifier.example",
+		ExpectedNonce:        "n-0S6_WzA2Mj",
+	}, resolver)
+
+	_, err := verifier.Verify(combine(issuerJWT, nil, kbJWT))
+	if err == nil {
+		t.Fatal("expected an expired key-binding JWT to be rejected, got nil")
+	}
+	if !strings.Contains(err.Error(), ErrInvalidKeyBinding.Error()) {
+		t.Fatalf("expected error to wrap ErrInvalidKeyBinding, got: %v", err)
+	}
+}
+
+// ecJWK converts pub into the JSON shape of an RFC 7517 EC P-256 JWK, matching what an issuer
+// would embed as a presentation's cnf.jwk.
+func ecJWK(pub *ecdsa.PublicKey) map[string]interface{} {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	pub.X.FillBytes(x)
+	pub.Y.FillBytes(y)
+
+	return map[string]interface{}{
+		"kty": "EC",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(x),
+		"y":   base64.RawURLEncoding.EncodeToString(y),
+	}
+}